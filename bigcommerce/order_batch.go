@@ -0,0 +1,222 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimiter throttles a batch worker pool using the rate limit state
+// BigCommerce reports on each response.
+type RateLimiter interface {
+	// Wait blocks until the caller may proceed, or returns ctx.Err() if ctx
+	// is canceled first.
+	Wait(ctx context.Context) error
+	// Update records the rate limit state from a completed request's
+	// response so future Wait calls can throttle accordingly. resp may be nil.
+	Update(resp *http.Response)
+}
+
+// HeaderRateLimiter is a RateLimiter that throttles using the
+// X-Rate-Limit-Requests-Left and X-Rate-Limit-Time-Reset-Ms headers
+// BigCommerce returns on every API response.
+type HeaderRateLimiter struct {
+	mu        sync.Mutex
+	remaining int
+	resetAt   time.Time
+}
+
+// Wait blocks until the reset window has passed if the last known remaining
+// request count has been exhausted.
+func (r *HeaderRateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	var wait time.Duration
+	if r.remaining <= 0 && !r.resetAt.IsZero() {
+		wait = time.Until(r.resetAt)
+	}
+	r.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Update parses the rate limit headers off resp, if present.
+func (r *HeaderRateLimiter) Update(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v := resp.Header.Get("X-Rate-Limit-Requests-Left"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			r.remaining = n
+		}
+	}
+	if v := resp.Header.Get("X-Rate-Limit-Time-Reset-Ms"); v != "" {
+		if ms, err := strconv.Atoi(v); err == nil {
+			r.resetAt = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		}
+	}
+}
+
+// BatchOptions configures the worker pool used by OrderService.BatchShow,
+// BatchEdit and BatchNew.
+type BatchOptions struct {
+	// Concurrency is the number of requests allowed in flight at once.
+	// Defaults to 1 if zero or negative.
+	Concurrency int
+	// StopOnError causes work not yet started to be skipped, on a
+	// best-effort basis, once any item has failed.
+	StopOnError bool
+	// RateLimiter, if set, is consulted before every request and updated
+	// from every response.
+	RateLimiter RateLimiter
+}
+
+func (o BatchOptions) concurrency() int {
+	if o.Concurrency <= 0 {
+		return 1
+	}
+	return o.Concurrency
+}
+
+// ErrBatchSkipped is returned in a BatchResult.Err for entries that were
+// never attempted because opts.StopOnError tripped on an earlier entry.
+var ErrBatchSkipped = errors.New("bigcommerce: batch entry skipped after an earlier error")
+
+// BatchResult carries the outcome of a single item from a batch operation.
+// Exactly one of Value or Err is meaningful: Err is nil on success. Response
+// is the raw HTTP response for the item's request, if one was made, so
+// callers can inspect the status code or headers behind a per-item failure;
+// it is nil for entries skipped via BatchOptions.StopOnError or rejected by
+// a RateLimiter before a request was ever sent.
+type BatchResult[T any] struct {
+	ID       int32
+	Value    T
+	Response *http.Response
+	Err      error
+}
+
+// batchEntry pairs a caller-facing ID with the input needed to process it.
+type batchEntry[I any] struct {
+	ID   int32
+	Item I
+}
+
+// runBatch fans work out across a bounded worker pool, calling fn once per
+// entry, honoring ctx cancellation, opts.RateLimiter and opts.StopOnError.
+// Results are returned in the same order as entries.
+func runBatch[I any, T any](ctx context.Context, entries []batchEntry[I], opts BatchOptions, fn func(ctx context.Context, id int32, item I) (T, *http.Response, error)) []BatchResult[T] {
+	results := make([]BatchResult[T], len(entries))
+	sem := make(chan struct{}, opts.concurrency())
+
+	var wg sync.WaitGroup
+	var stopped int32
+
+	for i, entry := range entries {
+		// Acquire a slot before checking stopped: a free slot means at least
+		// one earlier entry has finished (with opts.Concurrency of 1, every
+		// earlier entry has) and had a chance to set stopped before we
+		// decide whether to skip. Entries still in flight may fail later,
+		// so skipping is best-effort.
+		sem <- struct{}{}
+		if opts.StopOnError && atomic.LoadInt32(&stopped) != 0 {
+			<-sem
+			results[i] = BatchResult[T]{ID: entry.ID, Err: ErrBatchSkipped}
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, entry batchEntry[I]) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if opts.RateLimiter != nil {
+				if err := opts.RateLimiter.Wait(ctx); err != nil {
+					results[i] = BatchResult[T]{ID: entry.ID, Err: err}
+					return
+				}
+			}
+
+			value, resp, err := fn(ctx, entry.ID, entry.Item)
+			if opts.RateLimiter != nil {
+				opts.RateLimiter.Update(resp)
+			}
+
+			results[i] = BatchResult[T]{ID: entry.ID, Value: value, Response: resp, Err: err}
+			if err != nil && opts.StopOnError {
+				atomic.StoreInt32(&stopped, 1)
+			}
+		}(i, entry)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// BatchShow fetches each of ids concurrently, bounded by opts.Concurrency.
+func (s *OrderService) BatchShow(ctx context.Context, ids []int32, opts BatchOptions) ([]BatchResult[Order], error) {
+	entries := make([]batchEntry[int32], len(ids))
+	for i, id := range ids {
+		entries[i] = batchEntry[int32]{ID: id, Item: id}
+	}
+
+	results := runBatch(ctx, entries, opts, func(ctx context.Context, id int32, _ int32) (Order, *http.Response, error) {
+		order, resp, err := s.Show(ctx, id)
+		if order == nil {
+			return Order{}, resp, err
+		}
+		return *order, resp, err
+	})
+	return results, ctx.Err()
+}
+
+// BatchEdit applies each OrderEditParams in edits concurrently, bounded by
+// opts.Concurrency.
+func (s *OrderService) BatchEdit(ctx context.Context, edits map[int32]*OrderEditParams, opts BatchOptions) ([]BatchResult[Order], error) {
+	entries := make([]batchEntry[*OrderEditParams], 0, len(edits))
+	for id, params := range edits {
+		entries = append(entries, batchEntry[*OrderEditParams]{ID: id, Item: params})
+	}
+
+	results := runBatch(ctx, entries, opts, func(ctx context.Context, id int32, params *OrderEditParams) (Order, *http.Response, error) {
+		order, resp, err := s.Edit(ctx, id, params)
+		if order == nil {
+			return Order{}, resp, err
+		}
+		return *order, resp, err
+	})
+	return results, ctx.Err()
+}
+
+// BatchNew creates each OrderBody in bodies concurrently, bounded by
+// opts.Concurrency. The returned BatchResult.ID is the index of the body in
+// bodies, since a new order has no ID until it is created.
+func (s *OrderService) BatchNew(ctx context.Context, bodies []*OrderBody, opts BatchOptions) ([]BatchResult[Order], error) {
+	entries := make([]batchEntry[*OrderBody], len(bodies))
+	for i, body := range bodies {
+		entries[i] = batchEntry[*OrderBody]{ID: int32(i), Item: body}
+	}
+
+	results := runBatch(ctx, entries, opts, func(ctx context.Context, _ int32, body *OrderBody) (Order, *http.Response, error) {
+		order, resp, err := s.New(ctx, body)
+		if order == nil {
+			return Order{}, resp, err
+		}
+		return *order, resp, err
+	})
+	return results, ctx.Err()
+}
@@ -0,0 +1,166 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBatchAllSucceed(t *testing.T) {
+	entries := []batchEntry[int32]{{ID: 1}, {ID: 2}, {ID: 3}}
+	results := runBatch(context.Background(), entries, BatchOptions{Concurrency: 2},
+		func(ctx context.Context, id int32, _ int32) (int32, *http.Response, error) {
+			return id * 10, nil, nil
+		})
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			t.Errorf("results[%d].Err = %v, want nil", i, r.Err)
+		}
+		if r.Value != r.ID*10 {
+			t.Errorf("results[%d].Value = %d, want %d", i, r.Value, r.ID*10)
+		}
+	}
+}
+
+func TestRunBatchStopOnErrorSkipsRemainingEntries(t *testing.T) {
+	boom := errors.New("boom")
+	entries := []batchEntry[int32]{{ID: 1}, {ID: 2}, {ID: 3}}
+	results := runBatch(context.Background(), entries, BatchOptions{Concurrency: 1, StopOnError: true},
+		func(ctx context.Context, id int32, _ int32) (int32, *http.Response, error) {
+			if id == 2 {
+				return 0, nil, boom
+			}
+			return id, nil, nil
+		})
+
+	if results[1].Err != boom {
+		t.Fatalf("results[1].Err = %v, want %v", results[1].Err, boom)
+	}
+	// This is the behavior under review: a skipped entry must carry a
+	// real sentinel error, not a nil ctx.Err() that looks like success.
+	if results[2].Err != ErrBatchSkipped {
+		t.Fatalf("results[2].Err = %v, want ErrBatchSkipped", results[2].Err)
+	}
+	if results[2].Value != 0 {
+		t.Fatalf("results[2].Value = %d, want zero value for a skipped entry", results[2].Value)
+	}
+}
+
+func TestRunBatchWithoutStopOnErrorRunsEveryEntry(t *testing.T) {
+	boom := errors.New("boom")
+	entries := []batchEntry[int32]{{ID: 1}, {ID: 2}, {ID: 3}}
+	results := runBatch(context.Background(), entries, BatchOptions{Concurrency: 1},
+		func(ctx context.Context, id int32, _ int32) (int32, *http.Response, error) {
+			if id == 2 {
+				return 0, nil, boom
+			}
+			return id, nil, nil
+		})
+
+	if results[2].Err != nil {
+		t.Fatalf("results[2].Err = %v, want nil: entry 3 must still run when StopOnError is false", results[2].Err)
+	}
+}
+
+func TestRunBatchRespectsConcurrency(t *testing.T) {
+	const concurrency = 3
+	entries := make([]batchEntry[int32], 20)
+	for i := range entries {
+		entries[i] = batchEntry[int32]{ID: int32(i)}
+	}
+
+	var inFlight, maxInFlight int32
+	runBatch(context.Background(), entries, BatchOptions{Concurrency: concurrency},
+		func(ctx context.Context, id int32, _ int32) (int32, *http.Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			atomic.AddInt32(&inFlight, -1)
+			return id, nil, nil
+		})
+
+	if maxInFlight > concurrency {
+		t.Fatalf("observed %d entries in flight at once, want at most %d", maxInFlight, concurrency)
+	}
+}
+
+type stubRateLimiter struct {
+	waitCalls   int32
+	updateCalls int32
+}
+
+func (r *stubRateLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&r.waitCalls, 1)
+	return nil
+}
+
+func (r *stubRateLimiter) Update(resp *http.Response) {
+	atomic.AddInt32(&r.updateCalls, 1)
+}
+
+func TestRunBatchConsultsRateLimiter(t *testing.T) {
+	entries := []batchEntry[int32]{{ID: 1}, {ID: 2}}
+	limiter := &stubRateLimiter{}
+	runBatch(context.Background(), entries, BatchOptions{Concurrency: 1, RateLimiter: limiter},
+		func(ctx context.Context, id int32, _ int32) (int32, *http.Response, error) {
+			return id, nil, nil
+		})
+
+	if limiter.waitCalls != int32(len(entries)) {
+		t.Errorf("waitCalls = %d, want %d", limiter.waitCalls, len(entries))
+	}
+	if limiter.updateCalls != int32(len(entries)) {
+		t.Errorf("updateCalls = %d, want %d", limiter.updateCalls, len(entries))
+	}
+}
+
+func TestHeaderRateLimiterWaitsUntilReset(t *testing.T) {
+	limiter := &HeaderRateLimiter{}
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Rate-Limit-Requests-Left", "0")
+	resp.Header.Set("X-Rate-Limit-Time-Reset-Ms", "100000")
+	limiter.Update(resp)
+
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(context.Background()) }()
+
+	select {
+	case err := <-done:
+		t.Fatalf("Wait returned early with %v, want it to still be blocking", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHeaderRateLimiterWaitUnblocksOnContextCancel(t *testing.T) {
+	limiter := &HeaderRateLimiter{}
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Rate-Limit-Requests-Left", "0")
+	resp.Header.Set("X-Rate-Limit-Time-Reset-Ms", "100000")
+	limiter.Update(resp)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(ctx) }()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Fatalf("Wait returned %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not unblock after ctx was canceled")
+	}
+}
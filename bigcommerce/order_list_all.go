@@ -0,0 +1,136 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultListAllPageSize is used by ListAll when the caller's OrderListParams
+// does not set a Limit.
+const defaultListAllPageSize = 250
+
+// OrderResult is delivered on the channel returned by OrderService.ListAll.
+// Exactly one of Order or Err is set.
+type OrderResult struct {
+	Order *Order
+	Err   error
+}
+
+// ListAll walks every page of Orders matching params, starting from page 1
+// regardless of any Page set on params, and returns them on a channel as
+// they are fetched. It sizes the walk using OrderService.Count, retries
+// automatically on HTTP 429 honoring the Retry-After header, and stops
+// early if ctx is canceled. The channel is closed once every order has been
+// sent or an error is delivered.
+//
+// If params.Limit is zero, defaultListAllPageSize is used as the page size.
+func (s *OrderService) ListAll(ctx context.Context, params *OrderListParams) <-chan OrderResult {
+	out := make(chan OrderResult)
+
+	go func() {
+		defer close(out)
+
+		pageParams := *params
+		if pageParams.Limit <= 0 {
+			pageParams.Limit = defaultListAllPageSize
+		}
+
+		count, err := s.countWithRetry(ctx, &pageParams)
+		if err != nil {
+			sendResult(ctx, out, OrderResult{Err: err})
+			return
+		}
+
+		totalPages := int32(1)
+		if count.Count > 0 {
+			totalPages = (count.Count + pageParams.Limit - 1) / pageParams.Limit
+		}
+
+		for page := int32(1); page <= totalPages; page++ {
+			if ctx.Err() != nil {
+				sendResult(ctx, out, OrderResult{Err: ctx.Err()})
+				return
+			}
+
+			pageParams.Page = page
+			orders, err := s.listWithRetry(ctx, &pageParams)
+			if err != nil {
+				sendResult(ctx, out, OrderResult{Err: err})
+				return
+			}
+
+			for i := range *orders {
+				if !sendResult(ctx, out, OrderResult{Order: &(*orders)[i]}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// sendResult delivers r on out, returning false if ctx was canceled first.
+func sendResult(ctx context.Context, out chan<- OrderResult, r OrderResult) bool {
+	select {
+	case out <- r:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// listWithRetry calls List, retrying with the server-requested back-off
+// whenever the response is rate-limited.
+func (s *OrderService) listWithRetry(ctx context.Context, params *OrderListParams) (*Orders, error) {
+	for {
+		orders, resp, err := s.List(ctx, params)
+		if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+			return orders, err
+		}
+
+		select {
+		case <-time.After(retryAfter(resp)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// countWithRetry calls Count, retrying with the server-requested back-off
+// whenever the response is rate-limited.
+func (s *OrderService) countWithRetry(ctx context.Context, params *OrderListParams) (*Count, error) {
+	for {
+		count, resp, err := s.Count(ctx, params)
+		if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+			return count, err
+		}
+
+		select {
+		case <-time.After(retryAfter(resp)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// retryAfter returns how long to wait before retrying a 429 response, based
+// on its Retry-After header, defaulting to one second if the header is
+// missing or unparseable.
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return time.Second
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return time.Second
+}
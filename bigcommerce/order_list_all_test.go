@@ -0,0 +1,83 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOrderServiceListAllRetriesOnRateLimit(t *testing.T) {
+	var countAttempts, pageAttempts int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&countAttempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"count": 1}`)
+	})
+	mux.HandleFunc("/orders/", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&pageAttempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderService(base.New(), http.DefaultClient)
+
+	var got []int32
+	for result := range svc.ListAll(context.Background(), &OrderListParams{}) {
+		if result.Err != nil {
+			t.Fatalf("ListAll delivered error: %v", result.Err)
+		}
+		got = append(got, result.Order.ID)
+	}
+
+	if len(got) != 1 || got[0] != 1 {
+		t.Fatalf("got orders %v, want [1]", got)
+	}
+	if countAttempts != 2 {
+		t.Errorf("countAttempts = %d, want 2 (one 429, one success)", countAttempts)
+	}
+	if pageAttempts != 2 {
+		t.Errorf("pageAttempts = %d, want 2 (one 429, one success)", pageAttempts)
+	}
+}
+
+func TestOrderServiceListAllStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/count", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 2}`)
+	})
+	mux.HandleFunc("/orders/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderService(base.New(), http.DefaultClient)
+
+	out := svc.ListAll(ctx, &OrderListParams{Limit: 1})
+	first, ok := <-out
+	if !ok || first.Err != nil {
+		t.Fatalf("first result = %+v, ok = %v, want a successful order", first, ok)
+	}
+
+	cancel()
+
+	for result := range out {
+		if result.Err == nil {
+			t.Fatalf("got order %+v after ctx cancellation, want only an error or nothing", result.Order)
+		}
+	}
+}
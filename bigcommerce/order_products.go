@@ -0,0 +1,213 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// OrderProductAppliedDiscount describes a discount that was applied to an OrderProduct.
+type OrderProductAppliedDiscount struct {
+	ID     string `json:"id"`
+	Amount string `json:"amount"`
+	Name   string `json:"name"`
+	Code   string `json:"code"`
+	Target string `json:"target"`
+}
+
+// OrderProductOption describes a product option selected for an OrderProduct,
+// e.g. a size or color chosen at checkout.
+type OrderProductOption struct {
+	ID              int32  `json:"id"`
+	OrderProductID  int32  `json:"order_product_id"`
+	ProductOptionID int32  `json:"product_option_id"`
+	DisplayName     string `json:"display_name"`
+	DisplayValue    string `json:"display_value"`
+	DisplayStyle    string `json:"display_style"`
+	Value           string `json:"value"`
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+}
+
+// OrderProductConfigurableField describes a configurable field value captured
+// for an OrderProduct, e.g. custom text or an uploaded file reference.
+type OrderProductConfigurableField struct {
+	OrderProductID int32  `json:"order_product_id"`
+	Name           string `json:"name"`
+	Value          string `json:"value"`
+}
+
+// OrderProductListParams are the parameters for OrderProductService.List
+type OrderProductListParams struct {
+	Page  int32 `url:"page,omitempty"`
+	Limit int32 `url:"limit,omitempty"`
+}
+
+// OrderProductUpdateParams describes the fields that are editable on an OrderProduct.
+type OrderProductUpdateParams struct {
+	Quantity         *int32 `json:"quantity,omitempty"`
+	QuantityShipped  *int32 `json:"quantity_shipped,omitempty"`
+	QuantityRefunded *int32 `json:"quantity_refunded,omitempty"`
+}
+
+// OrderProductService adds the APIs for the OrderProduct subresource.
+type OrderProductService struct {
+	sling      *sling.Sling
+	httpClient *http.Client
+}
+
+func newOrderProductService(sling *sling.Sling, httpClient *http.Client) *OrderProductService {
+	return &OrderProductService{
+		sling:      sling.Path("products/"),
+		httpClient: httpClient,
+	}
+}
+
+// List returns a list of OrderProducts for the order matching the given OrderProductListParams.
+func (s *OrderProductService) List(ctx context.Context, params *OrderProductListParams) (*OrderProducts, *http.Response, error) {
+	products := new(OrderProducts)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().QueryStruct(params), s.httpClient, products, apiError)
+	return products, resp, relevantError(err, *apiError)
+}
+
+// Show returns the requested OrderProduct.
+func (s *OrderProductService) Show(ctx context.Context, productID int32) (*OrderProduct, *http.Response, error) {
+	product := new(OrderProduct)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Get(fmt.Sprintf("%d", productID)), s.httpClient, product, apiError)
+	return product, resp, relevantError(err, *apiError)
+}
+
+// Update updates the given OrderProductUpdateParams of the given OrderProduct.
+func (s *OrderProductService) Update(ctx context.Context, productID int32, params *OrderProductUpdateParams) (*OrderProduct, *http.Response, error) {
+	product := new(OrderProduct)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Put(fmt.Sprintf("%d", productID)).BodyJSON(params), s.httpClient, product, apiError)
+	return product, resp, relevantError(err, *apiError)
+}
+
+// OrderShippingAddress describes a shipping address attached to an Order.
+type OrderShippingAddress struct {
+	ID      int32 `json:"id"`
+	OrderID int32 `json:"order_id"`
+	AddressEntity
+	ShippingMethod string `json:"shipping_method"`
+	BaseCostExTax  string `json:"base_cost_ex_tax"`
+	BaseCostIncTax string `json:"base_cost_inc_tax"`
+	CostExTax      string `json:"cost_ex_tax"`
+	CostIncTax     string `json:"cost_inc_tax"`
+	ItemsTotal     int32  `json:"items_total"`
+	ItemsShipped   int32  `json:"items_shipped"`
+}
+
+// OrderShippingAddresses defines a list of the OrderShippingAddress object.
+type OrderShippingAddresses []OrderShippingAddress
+
+// OrderShippingAddressListParams are the parameters for OrderShippingAddressService.List
+type OrderShippingAddressListParams struct {
+	Page  int32 `url:"page,omitempty"`
+	Limit int32 `url:"limit,omitempty"`
+}
+
+// OrderShippingAddressService adds the APIs for the OrderShippingAddress subresource.
+type OrderShippingAddressService struct {
+	sling      *sling.Sling
+	httpClient *http.Client
+}
+
+func newOrderShippingAddressService(sling *sling.Sling, httpClient *http.Client) *OrderShippingAddressService {
+	return &OrderShippingAddressService{
+		sling:      sling.Path("shipping_addresses/"),
+		httpClient: httpClient,
+	}
+}
+
+// List returns a list of OrderShippingAddresses for the order matching the given OrderShippingAddressListParams.
+func (s *OrderShippingAddressService) List(ctx context.Context, params *OrderShippingAddressListParams) (*OrderShippingAddresses, *http.Response, error) {
+	addresses := new(OrderShippingAddresses)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().QueryStruct(params), s.httpClient, addresses, apiError)
+	return addresses, resp, relevantError(err, *apiError)
+}
+
+// Show returns the requested OrderShippingAddress.
+func (s *OrderShippingAddressService) Show(ctx context.Context, addressID int32) (*OrderShippingAddress, *http.Response, error) {
+	address := new(OrderShippingAddress)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Get(fmt.Sprintf("%d", addressID)), s.httpClient, address, apiError)
+	return address, resp, relevantError(err, *apiError)
+}
+
+// OrderCoupon describes a coupon that was redeemed against an Order.
+type OrderCoupon struct {
+	ID          int32  `json:"id"`
+	CouponID    int32  `json:"coupon_id"`
+	OrderID     int32  `json:"order_id"`
+	Code        string `json:"code"`
+	DisplayName string `json:"display_name"`
+	Amount      string `json:"amount"`
+	Type        int32  `json:"type"`
+}
+
+// OrderCoupons defines a list of the OrderCoupon object.
+type OrderCoupons []OrderCoupon
+
+// OrderCouponListParams are the parameters for OrderCouponService.List
+type OrderCouponListParams struct {
+	Page  int32 `url:"page,omitempty"`
+	Limit int32 `url:"limit,omitempty"`
+}
+
+// OrderCouponService adds the APIs for the OrderCoupon subresource.
+type OrderCouponService struct {
+	sling      *sling.Sling
+	httpClient *http.Client
+}
+
+func newOrderCouponService(sling *sling.Sling, httpClient *http.Client) *OrderCouponService {
+	return &OrderCouponService{
+		sling:      sling.Path("coupons/"),
+		httpClient: httpClient,
+	}
+}
+
+// List returns a list of OrderCoupons for the order matching the given OrderCouponListParams.
+func (s *OrderCouponService) List(ctx context.Context, params *OrderCouponListParams) (*OrderCoupons, *http.Response, error) {
+	coupons := new(OrderCoupons)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().QueryStruct(params), s.httpClient, coupons, apiError)
+	return coupons, resp, relevantError(err, *apiError)
+}
+
+// Show returns the requested OrderCoupon.
+func (s *OrderCouponService) Show(ctx context.Context, couponID int32) (*OrderCoupon, *http.Response, error) {
+	coupon := new(OrderCoupon)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Get(fmt.Sprintf("%d", couponID)), s.httpClient, coupon, apiError)
+	return coupon, resp, relevantError(err, *apiError)
+}
+
+// Products returns an OrderProductService scoped to the given order.
+func (s *OrderService) Products(orderID int32) *OrderProductService {
+	return newOrderProductService(s.sling.New().Path(fmt.Sprintf("%d/", orderID)), s.httpClient)
+}
+
+// ShippingAddresses returns an OrderShippingAddressService scoped to the given order.
+func (s *OrderService) ShippingAddresses(orderID int32) *OrderShippingAddressService {
+	return newOrderShippingAddressService(s.sling.New().Path(fmt.Sprintf("%d/", orderID)), s.httpClient)
+}
+
+// Coupons returns an OrderCouponService scoped to the given order.
+func (s *OrderService) Coupons(orderID int32) *OrderCouponService {
+	return newOrderCouponService(s.sling.New().Path(fmt.Sprintf("%d/", orderID)), s.httpClient)
+}
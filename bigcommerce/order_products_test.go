@@ -0,0 +1,192 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dghubble/sling"
+)
+
+// Fixtures below are trimmed recordings of the BigCommerce v2 Orders API
+// responses for /orders/{id}/products, /orders/{id}/shipping_addresses and
+// /orders/{id}/coupons.
+const orderProductsListFixture = `[
+	{
+		"id": 1,
+		"order_id": 100,
+		"product_id": 55,
+		"name": "Smith Journal 13",
+		"quantity": 2,
+		"base_price": "29.9900",
+		"price_ex_tax": 29.99,
+		"price_inc_tax": 29.99,
+		"applied_discounts": [
+			{"id": "1", "amount": "5.0000", "name": "Launch Sale", "code": "LAUNCH", "target": "order"}
+		],
+		"product_options": [
+			{"id": 1, "order_product_id": 1, "product_option_id": 9, "display_name": "Color", "display_value": "Blue", "value": "blue", "type": "swatch", "name": "Color"}
+		],
+		"configurable_fields": [
+			{"order_product_id": 1, "name": "Engraving", "value": "Happy Birthday"}
+		]
+	}
+]`
+
+const orderProductShowFixture = `{
+	"id": 1,
+	"order_id": 100,
+	"product_id": 55,
+	"name": "Smith Journal 13",
+	"quantity": 2,
+	"ebay_listing_id": "112233",
+	"ebay_transaction_id": "445566"
+}`
+
+const orderShippingAddressesListFixture = `[
+	{
+		"id": 1,
+		"order_id": 100,
+		"first_name": "Jane",
+		"last_name": "Doe",
+		"street_1": "123 Main St",
+		"city": "Austin",
+		"zip": "78701",
+		"country": "United States",
+		"shipping_method": "Flat Rate",
+		"base_cost_ex_tax": "5.0000",
+		"cost_ex_tax": "5.0000",
+		"items_total": 2,
+		"items_shipped": 0
+	}
+]`
+
+const orderCouponsListFixture = `[
+	{"id": 1, "coupon_id": 42, "order_id": 100, "code": "LAUNCH", "display_name": "Launch Sale", "amount": "5.0000", "type": 1}
+]`
+
+func newTestSling(t *testing.T, mux *http.ServeMux) (*sling.Sling, func()) {
+	t.Helper()
+	ts := httptest.NewServer(mux)
+	return sling.New().Base(ts.URL + "/"), ts.Close
+}
+
+func TestOrderProductServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/products/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, orderProductsListFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderProductService(base.New().Path("orders/100/"), http.DefaultClient)
+	products, _, err := svc.List(context.Background(), &OrderProductListParams{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(*products) != 1 {
+		t.Fatalf("got %d products, want 1", len(*products))
+	}
+
+	got := (*products)[0]
+	if got.ID != 1 || got.ProductID != 55 || got.ProductName != "Smith Journal 13" {
+		t.Errorf("unexpected product: %+v", got)
+	}
+	if len(got.AppliedDiscounts) != 1 || got.AppliedDiscounts[0].Code != "LAUNCH" {
+		t.Errorf("unexpected applied discounts: %+v", got.AppliedDiscounts)
+	}
+	if len(got.ProductOptions) != 1 || got.ProductOptions[0].DisplayValue != "Blue" {
+		t.Errorf("unexpected product options: %+v", got.ProductOptions)
+	}
+	if len(got.ConfigurableFields) != 1 || got.ConfigurableFields[0].Value != "Happy Birthday" {
+		t.Errorf("unexpected configurable fields: %+v", got.ConfigurableFields)
+	}
+}
+
+func TestOrderProductServiceShow(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/products/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, orderProductShowFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderProductService(base.New().Path("orders/100/"), http.DefaultClient)
+	product, _, err := svc.Show(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Show returned error: %v", err)
+	}
+	if product.EbayListingID != "112233" || product.EbayTransactionID != "445566" {
+		t.Errorf("unexpected product: %+v", product)
+	}
+}
+
+func TestOrderProductServiceUpdate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/products/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s, want PUT", r.Method)
+		}
+		fmt.Fprint(w, orderProductShowFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderProductService(base.New().Path("orders/100/"), http.DefaultClient)
+	qty := int32(3)
+	product, _, err := svc.Update(context.Background(), 1, &OrderProductUpdateParams{Quantity: &qty})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if product.ID != 1 {
+		t.Errorf("unexpected product: %+v", product)
+	}
+}
+
+func TestOrderShippingAddressServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/shipping_addresses/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, orderShippingAddressesListFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderShippingAddressService(base.New().Path("orders/100/"), http.DefaultClient)
+	addresses, _, err := svc.List(context.Background(), &OrderShippingAddressListParams{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(*addresses) != 1 {
+		t.Fatalf("got %d addresses, want 1", len(*addresses))
+	}
+
+	got := (*addresses)[0]
+	if got.FirstName != "Jane" || got.ShippingMethod != "Flat Rate" || got.ItemsTotal != 2 {
+		t.Errorf("unexpected shipping address: %+v", got)
+	}
+}
+
+func TestOrderCouponServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/coupons/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, orderCouponsListFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newOrderCouponService(base.New().Path("orders/100/"), http.DefaultClient)
+	coupons, _, err := svc.List(context.Background(), &OrderCouponListParams{})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(*coupons) != 1 {
+		t.Fatalf("got %d coupons, want 1", len(*coupons))
+	}
+
+	got := (*coupons)[0]
+	if got.Code != "LAUNCH" || got.CouponID != 42 {
+		t.Errorf("unexpected coupon: %+v", got)
+	}
+}
@@ -0,0 +1,102 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// RefundItem describes the amount to refund for a single OrderProduct line item.
+type RefundItem struct {
+	OrderProductID int32  `json:"order_product_id"`
+	Quantity       int32  `json:"quantity,omitempty"`
+	Amount         string `json:"amount,omitempty"`
+}
+
+// RefundPayment names the transaction a refund should be taken against and
+// how much of it to refund.
+type RefundPayment struct {
+	PaymentID string `json:"payment_id"`
+	Amount    string `json:"amount"`
+}
+
+// RefundBody describes the information given when quoting or creating a refund.
+type RefundBody struct {
+	Items          []RefundItem    `json:"items,omitempty"`
+	ShippingAmount string          `json:"shipping_amount,omitempty"`
+	TaxAmount      string          `json:"tax_amount,omitempty"`
+	Payments       []RefundPayment `json:"payments,omitempty"`
+	Reason         string          `json:"reason,omitempty"`
+}
+
+// Refund describes a refund raised against an Order.
+type Refund struct {
+	ID          int32           `json:"id"`
+	OrderID     int32           `json:"order_id"`
+	DateCreated string          `json:"date_created"`
+	Status      string          `json:"status"`
+	Amount      string          `json:"amount"`
+	Items       []RefundItem    `json:"items"`
+	Payments    []RefundPayment `json:"payments"`
+}
+
+// Refunds defines a list of the Refund object.
+type Refunds []Refund
+
+// RefundService adds the APIs for the Refund subresource.
+type RefundService struct {
+	sling      *sling.Sling
+	httpClient *http.Client
+}
+
+func newRefundService(sling *sling.Sling, httpClient *http.Client) *RefundService {
+	return &RefundService{
+		sling:      sling.Path("payment_actions/"),
+		httpClient: httpClient,
+	}
+}
+
+// List returns the Refunds raised against the order.
+func (s *RefundService) List(ctx context.Context) (*Refunds, *http.Response, error) {
+	refunds := new(Refunds)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Get("refunds"), s.httpClient, refunds, apiError)
+	return refunds, resp, relevantError(err, *apiError)
+}
+
+// Show returns the requested Refund.
+func (s *RefundService) Show(ctx context.Context, refundID int32) (*Refund, *http.Response, error) {
+	refund := new(Refund)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Get(fmt.Sprintf("refunds/%d", refundID)), s.httpClient, refund, apiError)
+	return refund, resp, relevantError(err, *apiError)
+}
+
+// Quote previews the Refund that would be created from the given RefundBody,
+// without charging anything, via /orders/{id}/payment_actions/refund_quotes.
+func (s *RefundService) Quote(ctx context.Context, body *RefundBody) (*Refund, *http.Response, error) {
+	refund := new(Refund)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Post("refund_quotes").BodyJSON(body), s.httpClient, refund, apiError)
+	return refund, resp, relevantError(err, *apiError)
+}
+
+// Create raises a new Refund against the order with the given RefundBody,
+// via /orders/{id}/payment_actions/refunds.
+func (s *RefundService) Create(ctx context.Context, body *RefundBody) (*Refund, *http.Response, error) {
+	refund := new(Refund)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Post("refunds").BodyJSON(body), s.httpClient, refund, apiError)
+	return refund, resp, relevantError(err, *apiError)
+}
+
+// Refunds returns a RefundService scoped to the given order.
+func (s *OrderService) Refunds(orderID int32) *RefundService {
+	return newRefundService(s.sling.New().Path(fmt.Sprintf("%d/", orderID)), s.httpClient)
+}
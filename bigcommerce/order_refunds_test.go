@@ -0,0 +1,89 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const refundFixture = `{
+	"id": 1,
+	"order_id": 100,
+	"date_created": "Tue, 01 Jul 2025 12:00:00 +0000",
+	"status": "pending",
+	"amount": "29.9900",
+	"items": [
+		{"order_product_id": 1, "quantity": 1, "amount": "29.9900"}
+	],
+	"payments": [
+		{"payment_id": "txn_123", "amount": "29.9900"}
+	]
+}`
+
+func TestRefundServiceQuote(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/payment_actions/refund_quotes", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		fmt.Fprint(w, refundFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newRefundService(base.New().Path("orders/100/"), http.DefaultClient)
+	refund, _, err := svc.Quote(context.Background(), &RefundBody{
+		Items: []RefundItem{{OrderProductID: 1, Quantity: 1, Amount: "29.9900"}},
+	})
+	if err != nil {
+		t.Fatalf("Quote returned error: %v", err)
+	}
+	if refund.Status != "pending" || refund.Amount != "29.9900" {
+		t.Errorf("unexpected refund: %+v", refund)
+	}
+	if len(refund.Payments) != 1 || refund.Payments[0].PaymentID != "txn_123" {
+		t.Errorf("unexpected refund payments: %+v", refund.Payments)
+	}
+}
+
+func TestRefundServiceCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/payment_actions/refunds", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		fmt.Fprint(w, refundFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newRefundService(base.New().Path("orders/100/"), http.DefaultClient)
+	refund, _, err := svc.Create(context.Background(), &RefundBody{
+		Payments: []RefundPayment{{PaymentID: "txn_123", Amount: "29.9900"}},
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if refund.ID != 1 || refund.OrderID != 100 {
+		t.Errorf("unexpected refund: %+v", refund)
+	}
+}
+
+func TestRefundServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/payment_actions/refunds", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "[%s]", refundFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newRefundService(base.New().Path("orders/100/"), http.DefaultClient)
+	refunds, _, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(*refunds) != 1 || (*refunds)[0].ID != 1 {
+		t.Fatalf("unexpected refunds: %+v", refunds)
+	}
+}
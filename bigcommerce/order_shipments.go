@@ -0,0 +1,157 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dghubble/sling"
+)
+
+// ShippingProvider identifies the carrier a Shipment was sent with.
+type ShippingProvider string
+
+// Carrier codes accepted by the BigCommerce shipments API.
+const (
+	ShippingProviderFedex     ShippingProvider = "fedex"
+	ShippingProviderUPS       ShippingProvider = "ups"
+	ShippingProviderUSPS      ShippingProvider = "usps"
+	ShippingProviderDHL       ShippingProvider = "dhl"
+	ShippingProviderJTExpress ShippingProvider = "jt-express"
+	ShippingProviderSendcloud ShippingProvider = "sendcloud"
+	ShippingProviderCustom    ShippingProvider = "custom"
+)
+
+// ShipmentItem describes the quantity of a single OrderProduct being shipped
+// as part of a Shipment.
+type ShipmentItem struct {
+	OrderProductID int32 `json:"order_product_id"`
+	Quantity       int32 `json:"quantity"`
+}
+
+// ShipmentBody describes the information given when creating or updating a Shipment.
+type ShipmentBody struct {
+	OrderAddressID   int32            `json:"order_address_id"`
+	TrackingNumber   string           `json:"tracking_number,omitempty"`
+	ShippingMethod   string           `json:"shipping_method,omitempty"`
+	ShippingProvider ShippingProvider `json:"shipping_provider,omitempty"`
+	TrackingCarrier  string           `json:"tracking_carrier,omitempty"`
+	Comments         string           `json:"comments,omitempty"`
+	Items            []ShipmentItem   `json:"items"`
+}
+
+// Shipment describes a shipment raised against an Order.
+type Shipment struct {
+	ID               int32            `json:"id"`
+	OrderID          int32            `json:"order_id"`
+	CustomerID       int32            `json:"customer_id"`
+	OrderAddressID   int32            `json:"order_address_id"`
+	DateCreated      string           `json:"date_created"`
+	TrackingNumber   string           `json:"tracking_number"`
+	TrackingCarrier  string           `json:"tracking_carrier"`
+	TrackingLink     string           `json:"tracking_link"`
+	ShippingProvider ShippingProvider `json:"shipping_provider"`
+	ShippingMethod   string           `json:"shipping_method"`
+	Comments         string           `json:"comments"`
+	ItemsTotal       int32            `json:"items_total"`
+	Items            []ShipmentItem   `json:"items"`
+}
+
+// trackingURLTemplates maps a ShippingProvider to a carrier tracking page
+// URL, with a "%s" placeholder for the URL-escaped tracking number.
+var trackingURLTemplates = map[ShippingProvider]string{
+	ShippingProviderFedex:     "https://www.fedex.com/fedextrack/?trknbr=%s",
+	ShippingProviderUPS:       "https://www.ups.com/track?tracknum=%s",
+	ShippingProviderUSPS:      "https://tools.usps.com/go/TrackConfirmAction?tLabels=%s",
+	ShippingProviderDHL:       "https://www.dhl.com/en/express/tracking.html?AWB=%s",
+	ShippingProviderJTExpress: "https://www.jtexpress.sg/index/query/gzquery.html?bills=%s",
+	ShippingProviderSendcloud: "https://tracking.sendcloud.sc/tracking/%s",
+}
+
+// TrackingURL returns the carrier tracking URL for the Shipment. For a
+// ShippingProvider with a known carrier, it is built from ShippingProvider
+// and TrackingNumber, so it is available immediately after Create rather
+// than depending on BigCommerce having populated TrackingLink. For
+// ShippingProviderCustom and unrecognized providers, it falls back to
+// TrackingLink, the carrier-neutral URL the merchant's own integration
+// supplied. It returns the empty string if neither yields a URL, e.g. when
+// TrackingNumber is not yet set.
+func (s *Shipment) TrackingURL() string {
+	if tmpl, ok := trackingURLTemplates[s.ShippingProvider]; ok && s.TrackingNumber != "" {
+		return fmt.Sprintf(tmpl, url.QueryEscape(s.TrackingNumber))
+	}
+	return s.TrackingLink
+}
+
+// Shipments defines a list of the Shipment object.
+type Shipments []Shipment
+
+// ShipmentListParams are the parameters for ShipmentService.List
+type ShipmentListParams struct {
+	Page           int32  `url:"page,omitempty"`
+	Limit          int32  `url:"limit,omitempty"`
+	TrackingNumber string `url:"tracking_number,omitempty"`
+}
+
+// ShipmentService adds the APIs for the Shipment subresource.
+type ShipmentService struct {
+	sling      *sling.Sling
+	httpClient *http.Client
+}
+
+func newShipmentService(sling *sling.Sling, httpClient *http.Client) *ShipmentService {
+	return &ShipmentService{
+		sling:      sling.Path("shipments/"),
+		httpClient: httpClient,
+	}
+}
+
+// List returns a list of Shipments for the order matching the given ShipmentListParams.
+func (s *ShipmentService) List(ctx context.Context, params *ShipmentListParams) (*Shipments, *http.Response, error) {
+	shipments := new(Shipments)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().QueryStruct(params), s.httpClient, shipments, apiError)
+	return shipments, resp, relevantError(err, *apiError)
+}
+
+// Show returns the requested Shipment.
+func (s *ShipmentService) Show(ctx context.Context, id int32) (*Shipment, *http.Response, error) {
+	shipment := new(Shipment)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Get(fmt.Sprintf("%d", id)), s.httpClient, shipment, apiError)
+	return shipment, resp, relevantError(err, *apiError)
+}
+
+// Create raises a new Shipment for the order with the given ShipmentBody.
+func (s *ShipmentService) Create(ctx context.Context, body *ShipmentBody) (*Shipment, *http.Response, error) {
+	shipment := new(Shipment)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Post("").BodyJSON(body), s.httpClient, shipment, apiError)
+	return shipment, resp, relevantError(err, *apiError)
+}
+
+// Update updates the given Shipment with the given ShipmentBody.
+func (s *ShipmentService) Update(ctx context.Context, id int32, body *ShipmentBody) (*Shipment, *http.Response, error) {
+	shipment := new(Shipment)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Put(fmt.Sprintf("%d", id)).BodyJSON(body), s.httpClient, shipment, apiError)
+	return shipment, resp, relevantError(err, *apiError)
+}
+
+// Delete removes the given Shipment from the order.
+func (s *ShipmentService) Delete(ctx context.Context, id int32) (*http.Response, error) {
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New().Delete(fmt.Sprintf("%d", id)), s.httpClient, nil, apiError)
+	return resp, relevantError(err, *apiError)
+}
+
+// Shipments returns a ShipmentService scoped to the given order.
+func (s *OrderService) Shipments(orderID int32) *ShipmentService {
+	return newShipmentService(s.sling.New().Path(fmt.Sprintf("%d/", orderID)), s.httpClient)
+}
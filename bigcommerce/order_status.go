@@ -0,0 +1,142 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// OrderStatus is a typed representation of the BigCommerce order status_id.
+type OrderStatus int32
+
+// The order statuses defined by the BigCommerce Orders API.
+const (
+	OrderStatusIncomplete                 OrderStatus = 0
+	OrderStatusPending                    OrderStatus = 1
+	OrderStatusShipped                    OrderStatus = 2
+	OrderStatusPartiallyShipped           OrderStatus = 3
+	OrderStatusRefunded                   OrderStatus = 4
+	OrderStatusCancelled                  OrderStatus = 5
+	OrderStatusDeclined                   OrderStatus = 6
+	OrderStatusAwaitingPayment            OrderStatus = 7
+	OrderStatusAwaitingPickup             OrderStatus = 8
+	OrderStatusAwaitingShipment           OrderStatus = 9
+	OrderStatusCompleted                  OrderStatus = 10
+	OrderStatusAwaitingFulfillment        OrderStatus = 11
+	OrderStatusManualVerificationRequired OrderStatus = 12
+	OrderStatusDisputed                   OrderStatus = 13
+)
+
+// orderStatusNames maps an OrderStatus to the name BigCommerce shows in the
+// control panel and returns as Order.Status.
+var orderStatusNames = map[OrderStatus]string{
+	OrderStatusIncomplete:                 "Incomplete",
+	OrderStatusPending:                    "Pending",
+	OrderStatusShipped:                    "Shipped",
+	OrderStatusPartiallyShipped:           "Partially Shipped",
+	OrderStatusRefunded:                   "Refunded",
+	OrderStatusCancelled:                  "Cancelled",
+	OrderStatusDeclined:                   "Declined",
+	OrderStatusAwaitingPayment:            "Awaiting Payment",
+	OrderStatusAwaitingPickup:             "Awaiting Pickup",
+	OrderStatusAwaitingShipment:           "Awaiting Shipment",
+	OrderStatusCompleted:                  "Completed",
+	OrderStatusAwaitingFulfillment:        "Awaiting Fulfillment",
+	OrderStatusManualVerificationRequired: "Manual Verification Required",
+	OrderStatusDisputed:                   "Disputed",
+}
+
+// orderStatusTransitions lists the statuses that an order may move to from
+// a given status, per the BigCommerce order state machine.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusIncomplete:                 {OrderStatusPending, OrderStatusAwaitingPayment, OrderStatusCancelled},
+	OrderStatusPending:                    {OrderStatusAwaitingPayment, OrderStatusAwaitingFulfillment, OrderStatusDeclined, OrderStatusCancelled},
+	OrderStatusAwaitingPayment:            {OrderStatusAwaitingFulfillment, OrderStatusDeclined, OrderStatusCancelled},
+	OrderStatusAwaitingFulfillment:        {OrderStatusAwaitingShipment, OrderStatusAwaitingPickup, OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled, OrderStatusManualVerificationRequired},
+	OrderStatusAwaitingShipment:           {OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusAwaitingPickup:             {OrderStatusCompleted, OrderStatusCancelled},
+	OrderStatusPartiallyShipped:           {OrderStatusShipped, OrderStatusCompleted, OrderStatusRefunded, OrderStatusDisputed},
+	OrderStatusShipped:                    {OrderStatusCompleted, OrderStatusRefunded, OrderStatusDisputed},
+	OrderStatusManualVerificationRequired: {OrderStatusAwaitingFulfillment, OrderStatusDeclined, OrderStatusCancelled},
+	OrderStatusCompleted:                  {OrderStatusRefunded, OrderStatusDisputed},
+	OrderStatusDisputed:                   {OrderStatusRefunded, OrderStatusCompleted},
+}
+
+// String returns the BigCommerce display name for the OrderStatus, or a
+// fallback of the form "OrderStatus(<n>)" for an unrecognized value.
+func (s OrderStatus) String() string {
+	if name, ok := orderStatusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("OrderStatus(%d)", int32(s))
+}
+
+// EncodeValues implements the query.Encoder interface used by
+// github.com/google/go-querystring, so that an OrderStatus is sent as its
+// numeric status_id rather than its String() display name.
+func (s OrderStatus) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, strconv.Itoa(int(s)))
+	return nil
+}
+
+// IsTerminal reports whether the OrderStatus is an end state that an order
+// will not normally leave, e.g. Completed, Cancelled, Declined or Refunded.
+func (s OrderStatus) IsTerminal() bool {
+	switch s {
+	case OrderStatusCompleted, OrderStatusCancelled, OrderStatusDeclined, OrderStatusRefunded:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsWorking reports whether the OrderStatus represents an order that is
+// still being processed towards fulfillment.
+func (s OrderStatus) IsWorking() bool {
+	switch s {
+	case OrderStatusPending, OrderStatusAwaitingPayment, OrderStatusAwaitingFulfillment,
+		OrderStatusAwaitingShipment, OrderStatusAwaitingPickup, OrderStatusPartiallyShipped,
+		OrderStatusManualVerificationRequired:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether the order may move from the OrderStatus to
+// the given target status, per the BigCommerce order state machine.
+func (s OrderStatus) CanTransitionTo(target OrderStatus) bool {
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON accepts both the numeric status_id and the string status
+// name BigCommerce returns for an order, normalizing either into an OrderStatus.
+func (s *OrderStatus) UnmarshalJSON(data []byte) error {
+	var n int32
+	if err := json.Unmarshal(data, &n); err == nil {
+		*s = OrderStatus(n)
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if n, err := strconv.ParseInt(str, 10, 32); err == nil {
+		*s = OrderStatus(n)
+		return nil
+	}
+	for status, name := range orderStatusNames {
+		if name == str {
+			*s = status
+			return nil
+		}
+	}
+	return fmt.Errorf("bigcommerce: unknown order status %q", str)
+}
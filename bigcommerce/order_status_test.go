@@ -0,0 +1,107 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrderStatusString(t *testing.T) {
+	cases := []struct {
+		status OrderStatus
+		want   string
+	}{
+		{OrderStatusIncomplete, "Incomplete"},
+		{OrderStatusCompleted, "Completed"},
+		{OrderStatusManualVerificationRequired, "Manual Verification Required"},
+		{OrderStatus(99), "OrderStatus(99)"},
+	}
+	for _, c := range cases {
+		if got := c.status.String(); got != c.want {
+			t.Errorf("OrderStatus(%d).String() = %q, want %q", c.status, got, c.want)
+		}
+	}
+}
+
+func TestOrderStatusIsTerminal(t *testing.T) {
+	terminal := []OrderStatus{OrderStatusCompleted, OrderStatusCancelled, OrderStatusDeclined, OrderStatusRefunded}
+	for _, s := range terminal {
+		if !s.IsTerminal() {
+			t.Errorf("%s.IsTerminal() = false, want true", s)
+		}
+	}
+
+	notTerminal := []OrderStatus{OrderStatusPending, OrderStatusAwaitingFulfillment, OrderStatusDisputed}
+	for _, s := range notTerminal {
+		if s.IsTerminal() {
+			t.Errorf("%s.IsTerminal() = true, want false", s)
+		}
+	}
+}
+
+func TestOrderStatusIsWorking(t *testing.T) {
+	working := []OrderStatus{OrderStatusPending, OrderStatusAwaitingPayment, OrderStatusAwaitingFulfillment, OrderStatusAwaitingShipment}
+	for _, s := range working {
+		if !s.IsWorking() {
+			t.Errorf("%s.IsWorking() = false, want true", s)
+		}
+	}
+
+	notWorking := []OrderStatus{OrderStatusCompleted, OrderStatusIncomplete, OrderStatusDisputed}
+	for _, s := range notWorking {
+		if s.IsWorking() {
+			t.Errorf("%s.IsWorking() = true, want false", s)
+		}
+	}
+}
+
+func TestOrderStatusCanTransitionTo(t *testing.T) {
+	if !OrderStatusAwaitingFulfillment.CanTransitionTo(OrderStatusShipped) {
+		t.Error("expected AwaitingFulfillment -> Shipped to be a valid transition")
+	}
+	if OrderStatusCompleted.CanTransitionTo(OrderStatusIncomplete) {
+		t.Error("expected Completed -> Incomplete to be rejected")
+	}
+	if OrderStatusIncomplete.CanTransitionTo(OrderStatusCompleted) {
+		t.Error("expected Incomplete -> Completed to be rejected, it must go through the working states first")
+	}
+}
+
+func TestOrderStatusUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		json string
+		want OrderStatus
+	}{
+		{"numeric", `11`, OrderStatusAwaitingFulfillment},
+		{"numeric string", `"11"`, OrderStatusAwaitingFulfillment},
+		{"display name", `"Awaiting Fulfillment"`, OrderStatusAwaitingFulfillment},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got OrderStatus
+			if err := json.Unmarshal([]byte(c.json), &got); err != nil {
+				t.Fatalf("Unmarshal(%s) returned error: %v", c.json, err)
+			}
+			if got != c.want {
+				t.Errorf("Unmarshal(%s) = %v, want %v", c.json, got, c.want)
+			}
+		})
+	}
+}
+
+func TestOrderStatusUnmarshalJSONUnknown(t *testing.T) {
+	var got OrderStatus
+	if err := json.Unmarshal([]byte(`"Not A Real Status"`), &got); err == nil {
+		t.Fatal("expected an error for an unrecognized status name, got nil")
+	}
+}
+
+func TestOrderUsesOrderStatus(t *testing.T) {
+	var order Order
+	if err := json.Unmarshal([]byte(`{"status_id": "10", "status": "Completed"}`), &order); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if order.StatusID != OrderStatusCompleted {
+		t.Errorf("order.StatusID = %v, want %v", order.StatusID, OrderStatusCompleted)
+	}
+}
@@ -0,0 +1,53 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dghubble/sling"
+)
+
+// Transaction describes a payment transaction recorded against an Order.
+type Transaction struct {
+	ID                   int32  `json:"id"`
+	OrderID              int32  `json:"order_id"`
+	DateCreated          string `json:"date_created"`
+	Gateway              string `json:"gateway"`
+	GatewayTransactionID string `json:"gateway_transaction_id"`
+	Method               string `json:"method"`
+	Amount               string `json:"amount"`
+	Currency             string `json:"currency"`
+	Status               string `json:"status"`
+	ReferenceNumber      string `json:"reference_number"`
+}
+
+// Transactions defines a list of the Transaction object.
+type Transactions []Transaction
+
+// TransactionService adds the APIs for the Transaction subresource.
+type TransactionService struct {
+	sling      *sling.Sling
+	httpClient *http.Client
+}
+
+func newTransactionService(sling *sling.Sling, httpClient *http.Client) *TransactionService {
+	return &TransactionService{
+		sling:      sling.Path("transactions/"),
+		httpClient: httpClient,
+	}
+}
+
+// List returns the Transactions recorded against the order.
+func (s *TransactionService) List(ctx context.Context) (*Transactions, *http.Response, error) {
+	transactions := new(Transactions)
+	apiError := new(APIError)
+
+	resp, err := performRequest(ctx, s.sling.New(), s.httpClient, transactions, apiError)
+	return transactions, resp, relevantError(err, *apiError)
+}
+
+// Transactions returns a TransactionService scoped to the given order.
+func (s *OrderService) Transactions(orderID int32) *TransactionService {
+	return newTransactionService(s.sling.New().Path(fmt.Sprintf("%d/", orderID)), s.httpClient)
+}
@@ -0,0 +1,46 @@
+package bigcommerce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const orderTransactionsListFixture = `[
+	{
+		"id": 1,
+		"order_id": 100,
+		"date_created": "Tue, 01 Jul 2025 12:00:00 +0000",
+		"gateway": "authorizenet",
+		"gateway_transaction_id": "txn_123",
+		"method": "credit_card",
+		"amount": "29.9900",
+		"currency": "USD",
+		"status": "approved",
+		"reference_number": "REF-1"
+	}
+]`
+
+func TestTransactionServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orders/100/transactions/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, orderTransactionsListFixture)
+	})
+	base, closeServer := newTestSling(t, mux)
+	defer closeServer()
+
+	svc := newTransactionService(base.New().Path("orders/100/"), http.DefaultClient)
+	transactions, _, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(*transactions) != 1 {
+		t.Fatalf("got %d transactions, want 1", len(*transactions))
+	}
+
+	got := (*transactions)[0]
+	if got.Gateway != "authorizenet" || got.Status != "approved" || got.ReferenceNumber != "REF-1" {
+		t.Errorf("unexpected transaction: %+v", got)
+	}
+}
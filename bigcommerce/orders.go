@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/dghubble/sling"
 )
@@ -18,7 +20,7 @@ type Order struct {
 	DateCreated          string        `json:"date_created"`
 	DateModified         string        `json:"date_modified"`
 	DateShipped          string        `json:"date_shipped"`
-	StatusID             int32         `json:"status_id"`
+	StatusID             OrderStatus   `json:"status_id"`
 	Status               string        `json:"status"`
 	HandlingCostExTax    string        `json:"handling_cost_ex_tax"`
 	HandlingCostIncTax   string        `json:"handling_cost_inc_tax"`
@@ -62,18 +64,37 @@ func newOrderService(sling *sling.Sling, httpClient *http.Client) *OrderService
 
 // OrderListParams are the parameters for OrderService.List
 type OrderListParams struct {
-	Page          int32   `url:"page,omitempty"`
-	Limit         int32   `url:"limit,omitempty"`
-	Sort          string  `url:"sort,omitempty"`
-	MinID         int32   `url:"min_id,omitempty"`
-	MaxID         int32   `url:"max_id,omitempty"`
-	MinTotal      float32 `url:"min_total,omitempty"`
-	MaxTotal      float32 `url:"max_total,omitempty"`
-	CustomerID    *uint32 `url:"customer_id,omitempty"`
-	Email         string  `url:"email,omitempty"`
-	StatusID      *uint32 `url:"status_id,omitempty"`
-	PaymentMethod string  `url:"payment_method,omitempty"`
-	//TODO: add date and boolean based params.
+	Page            int32        `url:"page,omitempty"`
+	Limit           int32        `url:"limit,omitempty"`
+	Sort            string       `url:"sort,omitempty"`
+	MinID           int32        `url:"min_id,omitempty"`
+	MaxID           int32        `url:"max_id,omitempty"`
+	MinTotal        float32      `url:"min_total,omitempty"`
+	MaxTotal        float32      `url:"max_total,omitempty"`
+	CustomerID      *uint32      `url:"customer_id,omitempty"`
+	Email           string       `url:"email,omitempty"`
+	StatusID        *OrderStatus `url:"status_id,omitempty"`
+	PaymentMethod   string       `url:"payment_method,omitempty"`
+	MinDateCreated  *OrderDate   `url:"min_date_created,omitempty"`
+	MaxDateCreated  *OrderDate   `url:"max_date_created,omitempty"`
+	MinDateModified *OrderDate   `url:"min_date_modified,omitempty"`
+	MaxDateModified *OrderDate   `url:"max_date_modified,omitempty"`
+	IsDeleted       *bool        `url:"is_deleted,omitempty"`
+	ChannelID       int32        `url:"channel_id,omitempty"`
+	CartID          string       `url:"cart_id,omitempty"`
+	ExternalOrderID string       `url:"external_order_id,omitempty"`
+}
+
+// OrderDate wraps a time.Time so it marshals into the RFC 2822 date format
+// the BigCommerce Orders API expects for MinDateCreated / MaxDateCreated /
+// MinDateModified / MaxDateModified.
+type OrderDate time.Time
+
+// EncodeValues implements the query.Encoder interface used by
+// github.com/google/go-querystring, which sling relies on for QueryStruct.
+func (d OrderDate) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, time.Time(d).Format(time.RFC1123Z))
+	return nil
 }
 
 // List returns a list of Orders matching the given OrderListParams.
@@ -90,7 +111,7 @@ func (s *OrderService) Count(ctx context.Context, params *OrderListParams) (*Cou
 	count := new(Count)
 	apiError := new(APIError)
 
-	resp, err := performRequest(ctx, s.sling.Get("count").QueryStruct(params), s.httpClient, count, apiError)
+	resp, err := performRequest(ctx, s.sling.New().Get("count").QueryStruct(params), s.httpClient, count, apiError)
 	return count, resp, relevantError(err, *apiError)
 }
 
@@ -106,22 +127,51 @@ func (s *OrderService) Show(ctx context.Context, id int32) (*Order, *http.Respon
 // OrderProducts defines a list of the OrderProduct object.
 type OrderProducts []OrderProduct
 
-// OrderProduct defines a product to be included in the OrderBody.
+// OrderProduct defines a product included in an Order.
 // Regular Products require: ProductID and Quantity
 // Custom Products require: Name, Quantity and PriceIncTax / PriceExTax
+//
+// The fields below ID are only populated when the OrderProduct is read back
+// from the API, e.g. via OrderProductService.List / Show, rather than sent
+// as part of an OrderBody.
 type OrderProduct struct {
 	ProductID   int32   `json:"product_id,omitempty"`
 	ProductName string  `json:"name,omitempty"`
 	Quantity    int32   `json:"quantity"`
 	PriceIncTax float32 `json:"price_inc_tax,omitempty"`
 	PriceExTax  float32 `json:"price_ex_tax,omitempty"`
+
+	ID               int32  `json:"id,omitempty"`
+	OrderID          int32  `json:"order_id,omitempty"`
+	OrderAddressID   int32  `json:"order_address_id,omitempty"`
+	VariantID        int32  `json:"variant_id,omitempty"`
+	Sku              string `json:"sku,omitempty"`
+	Type             string `json:"type,omitempty"`
+	BasePrice        string `json:"base_price,omitempty"`
+	PriceTax         string `json:"price_tax,omitempty"`
+	BaseTotal        string `json:"base_total,omitempty"`
+	TotalIncTax      string `json:"total_inc_tax,omitempty"`
+	TotalExTax       string `json:"total_ex_tax,omitempty"`
+	TotalTax         string `json:"total_tax,omitempty"`
+	Weight           string `json:"weight,omitempty"`
+	QuantityShipped  int32  `json:"quantity_shipped,omitempty"`
+	QuantityRefunded int32  `json:"quantity_refunded,omitempty"`
+	IsRefunded       bool   `json:"is_refunded,omitempty"`
+	EventName        string `json:"event_name,omitempty"`
+
+	EbayListingID     string `json:"ebay_listing_id,omitempty"`
+	EbayTransactionID string `json:"ebay_transaction_id,omitempty"`
+
+	AppliedDiscounts   []OrderProductAppliedDiscount   `json:"applied_discounts,omitempty"`
+	ProductOptions     []OrderProductOption            `json:"product_options,omitempty"`
+	ConfigurableFields []OrderProductConfigurableField `json:"configurable_fields,omitempty"`
 }
 
 // OrderBody describes the order information given when creating a new Order.
 type OrderBody struct {
 	ExternalSource     string          `json:"external_source"`
 	CustomerID         *uint32         `json:"customer_id"`
-	StatusID           *uint32         `json:"status_id"`
+	StatusID           *OrderStatus    `json:"status_id"`
 	BillingAddress     AddressEntity   `json:"billing_address"`
 	Products           OrderProducts   `json:"products"`
 	ShippingCostIncTax float32         `json:"shipping_cost_inc_tax,omitempty"`
@@ -145,7 +195,7 @@ func (s *OrderService) New(ctx context.Context, body *OrderBody) (*Order, *http.
 // OrderEditParams describes the fields that are editable on an Order.
 type OrderEditParams struct {
 	CustomerID      *uint32       `json:"customer_id,omitempty"`
-	StatusID        *uint32       `json:"status_id,omitempty"`
+	StatusID        *OrderStatus  `json:"status_id,omitempty"`
 	IPAddress       string        `json:"ip_address,omitempty"`
 	StaffNotes      string        `json:"staff_notes,omitempty"`
 	CustomerMessage string        `json:"customer_message,omitempty"`